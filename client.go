@@ -0,0 +1,46 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk
+
+import (
+	"os"
+
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+)
+
+// Client creates a struct with services and top level methods that help with
+// interacting with the scrapegraphai-sdk API. You should not instantiate this
+// client directly, and instead use the [NewClient] method instead.
+type Client struct {
+	Options        []option.RequestOption
+	Markdownify    MarkdownifyService
+	Searchscraper  SearchscraperService
+	Smartcrawler   SmartcrawlerService
+	GenerateSchema GenerateSchemaService
+	Feedback       FeedbackService
+	Credits        CreditService
+	Validate       ValidateService
+}
+
+// NewClient generates a new client with the default option read from the
+// environment (SCRAPEGRAPHAI_API_KEY). The option passed in as arguments are
+// applied after these default arguments, and all option will be passed down
+// to the services and requests that this client makes.
+func NewClient(opts ...option.RequestOption) (r Client) {
+	defaults := []option.RequestOption{option.WithBaseURL("https://api.scrapegraphai.com/")}
+	if o, ok := os.LookupEnv("SCRAPEGRAPHAI_API_KEY"); ok {
+		defaults = append(defaults, option.WithAPIKey(o))
+	}
+	opts = append(defaults, opts...)
+
+	r = Client{Options: opts}
+
+	r.Markdownify = NewMarkdownifyService(opts...)
+	r.Searchscraper = NewSearchscraperService(opts...)
+	r.Smartcrawler = NewSmartcrawlerService(opts...)
+	r.GenerateSchema = NewGenerateSchemaService(opts...)
+	r.Feedback = NewFeedbackService(opts...)
+	r.Credits = NewCreditService(opts...)
+	r.Validate = NewValidateService(opts...)
+	return
+}
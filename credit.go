@@ -30,10 +30,17 @@ func NewCreditService(opts ...option.RequestOption) (r CreditService) {
 }
 
 // GET /credits
-func (r *CreditService) List(ctx context.Context, opts ...option.RequestOption) (err error) {
+func (r *CreditService) List(ctx context.Context, opts ...option.RequestOption) (res *CreditListResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	path := "v1/credits"
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
+
+// CreditListResponse is returned from [CreditService.List].
+type CreditListResponse struct {
+	// RemainingCredits is the balance available for further billable requests.
+	RemainingCredits int64 `json:"remaining_credits"`
+	// TotalCreditsUsed is the lifetime credit spend on the account.
+	TotalCreditsUsed int64 `json:"total_credits_used"`
+}
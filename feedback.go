@@ -8,6 +8,7 @@ import (
 
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
 )
 
 // FeedbackService contains methods and other services that help with interacting
@@ -30,10 +31,30 @@ func NewFeedbackService(opts ...option.RequestOption) (r FeedbackService) {
 }
 
 // POST /feedback
-func (r *FeedbackService) New(ctx context.Context, opts ...option.RequestOption) (err error) {
+func (r *FeedbackService) New(ctx context.Context, body FeedbackNewParams, opts ...option.RequestOption) (res *FeedbackNewResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	path := "v1/feedback"
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
 }
+
+// FeedbackNewParams is the request body for [FeedbackService.New].
+type FeedbackNewParams struct {
+	// RequestID identifies the scrape, search, or crawl job this feedback is for.
+	RequestID string `json:"request_id"`
+	// Rating is a score from 1 (worst) to 5 (best).
+	Rating int64 `json:"rating"`
+	// Comment is optional free-form feedback text.
+	Comment param.Opt[string] `json:"comment,omitzero"`
+	paramObj
+}
+
+func (r FeedbackNewParams) MarshalJSON() (data []byte, err error) {
+	type shadow FeedbackNewParams
+	return param.MarshalObject(r, (*shadow)(&r))
+}
+
+// FeedbackNewResponse is returned from [FeedbackService.New].
+type FeedbackNewResponse struct {
+	Success bool `json:"success"`
+}
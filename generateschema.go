@@ -8,8 +8,9 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/stainless-sdks/scrapegraphai-sdk-go/internal/requestconfig"
-	"github.com/stainless-sdks/scrapegraphai-sdk-go/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
 )
 
 // GenerateSchemaService contains methods and other services that help with
@@ -31,15 +32,59 @@ func NewGenerateSchemaService(opts ...option.RequestOption) (r GenerateSchemaSer
 	return
 }
 
+// POST /generate_schema
+func (r *GenerateSchemaService) New(ctx context.Context, body GenerateSchemaNewParams, opts ...option.RequestOption) (res *GenerateSchemaNewResponse, err error) {
+	opts = append(r.Options[:], opts...)
+	path := "generate_schema"
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
+	return
+}
+
 // GET /generate_schema/{request_id}
-func (r *GenerateSchemaService) Get(ctx context.Context, requestID string, opts ...option.RequestOption) (err error) {
+func (r *GenerateSchemaService) Get(ctx context.Context, requestID string, opts ...option.RequestOption) (res *GenerateSchemaGetResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	if requestID == "" {
 		err = errors.New("missing required request_id parameter")
 		return
 	}
 	path := fmt.Sprintf("generate_schema/%s", requestID)
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
+
+// GenerateSchemaNewParams is the request body for [GenerateSchemaService.New].
+type GenerateSchemaNewParams struct {
+	// UserPrompt describes the fields the generated schema should capture.
+	UserPrompt string `json:"user_prompt"`
+	// ExampleURL is a representative page the server uses to infer field types
+	// and structure.
+	ExampleURL param.Opt[string] `json:"example_url,omitzero"`
+	paramObj
+}
+
+func (r GenerateSchemaNewParams) MarshalJSON() (data []byte, err error) {
+	type shadow GenerateSchemaNewParams
+	return param.MarshalObject(r, (*shadow)(&r))
+}
+
+// GenerateSchemaNewResponse is returned from [GenerateSchemaService.New].
+type GenerateSchemaNewResponse struct {
+	// RequestID can be passed to [GenerateSchemaService.Get] to poll for the
+	// result.
+	RequestID string `json:"request_id"`
+	Status    string `json:"status"`
+}
+
+// GenerateSchemaGetResponse is returned from [GenerateSchemaService.Get].
+type GenerateSchemaGetResponse struct {
+	RequestID string `json:"request_id"`
+	// Status is one of "queued", "processing", "completed", "failed", or
+	// "cancelled".
+	Status string `json:"status"`
+	// Schema is only populated once Status is "completed", and is a JSON Schema
+	// object suitable for passing as OutputSchema to [SearchscraperService.New] or
+	// [SmartcrawlerService.New].
+	Schema map[string]any `json:"schema"`
+	// Error is only populated once Status is "failed".
+	Error string `json:"error"`
+}
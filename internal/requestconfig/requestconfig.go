@@ -0,0 +1,288 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+// Package requestconfig contains the configuration that every generated
+// service method threads through [option.RequestOption] values and finally
+// down to an executed HTTP request.
+package requestconfig
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestOption mutates a [RequestConfig] before a request is sent. Values
+// are produced by helpers in the option package, such as
+// [option.WithHeader] and [option.WithMaxRetries].
+type RequestOption func(*RequestConfig) error
+
+// RetryPolicy decides whether a request should be retried given the response
+// it received (which may be nil, if err is a network-level error) and the
+// error returned by the HTTP client, if any.
+type RetryPolicy func(res *http.Response, err error) bool
+
+// DefaultRetryPolicy retries network errors and 408, 429, and 5xx responses.
+func DefaultRetryPolicy(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return res.StatusCode >= 500
+	}
+}
+
+// RequestConfig accumulates everything needed to execute a single HTTP
+// request, as assembled by the chain of [RequestOption] values supplied by
+// the client, the service, and the call site.
+type RequestConfig struct {
+	Context        context.Context
+	HTTPClient     *http.Client
+	BaseURL        string
+	APIKey         string
+	Request        *http.Request
+	Body           any
+	Response       any
+	MaxRetries     int
+	RetryPolicy    RetryPolicy
+	MinRetryDelay  time.Duration
+	MaxRetryDelay  time.Duration
+	IdempotencyKey string
+
+	// CreditBudgetHook, if set by [option.WithCreditBudget], is invoked before
+	// a POST request is sent and may reject it by returning an error.
+	CreditBudgetHook func(cfg *RequestConfig) error
+	// CreditObserverHook, if set by [option.WithCreditBudget], is invoked after
+	// every successful response so the budget can learn the server-reported
+	// balance.
+	CreditObserverHook func(cfg *RequestConfig, res *http.Response)
+	// CreditReserved is set by a [CreditBudgetHook] that reserves credits
+	// against the budget, recording how much this particular request
+	// reserved so the matching CreditObserverHook call can release exactly
+	// that amount.
+	CreditReserved int64
+	// CreditReleaseHook, if set by [option.WithCreditBudget], is invoked
+	// when a request that reserved credits ultimately fails (a non-retryable
+	// error, retries exhausted, or the context ending while waiting to
+	// retry) so the reservation is released even
+	// though CreditObserverHook never runs for a failed request.
+	CreditReleaseHook func(cfg *RequestConfig)
+}
+
+// NewRequestConfig builds the default configuration for a request, before
+// any [RequestOption] values have been applied.
+func NewRequestConfig(ctx context.Context, method, path string, body any, res any) (*RequestConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, method, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestConfig{
+		Context:       ctx,
+		HTTPClient:    http.DefaultClient,
+		Request:       req,
+		Body:          body,
+		Response:      res,
+		MaxRetries:    2,
+		RetryPolicy:   DefaultRetryPolicy,
+		MinRetryDelay: 500 * time.Millisecond,
+		MaxRetryDelay: 8 * time.Second,
+	}, nil
+}
+
+// Apply runs every opt against the config, stopping at the first error.
+func (cfg *RequestConfig) Apply(opts ...RequestOption) error {
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecuteNewRequest builds the request described by method, path, and body,
+// applies opts, and executes it (retrying as configured), decoding a JSON
+// response body into res when res is non-nil.
+func ExecuteNewRequest(ctx context.Context, method, path string, body any, res any, opts ...RequestOption) error {
+	cfg, req, bodyBytes, err := buildRequest(ctx, method, path, body, res, opts...)
+	if err != nil {
+		return err
+	}
+	return executeWithRetry(cfg, req, bodyBytes, res)
+}
+
+// ExecuteNewStreamingRequest builds and sends the request described by
+// method, path, and body just like [ExecuteNewRequest], but returns the raw
+// *http.Response instead of decoding it as JSON, for callers that need to
+// read a streamed body (see the internal/streaming package). The response is
+// only retried if the initial connection attempt itself fails or is
+// rejected before any bytes of the body are read; once streaming begins it
+// is the caller's responsibility to reconnect.
+func ExecuteNewStreamingRequest(ctx context.Context, method, path string, body any, opts ...RequestOption) (*http.Response, error) {
+	cfg, req, bodyBytes, err := buildRequest(ctx, method, path, body, nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return doWithRetry(cfg, req, bodyBytes)
+}
+
+// buildRequest applies opts on top of the default [RequestConfig] and
+// prepares the *http.Request that executeWithRetry (or a streaming caller)
+// will send, including JSON-encoding body and attaching an Idempotency-Key
+// to POST requests.
+func buildRequest(ctx context.Context, method, path string, body any, res any, opts ...RequestOption) (*RequestConfig, *http.Request, []byte, error) {
+	cfg, err := NewRequestConfig(ctx, method, path, body, res)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := cfg.Apply(opts...); err != nil {
+		return nil, nil, nil, err
+	}
+
+	url := cfg.BaseURL + path
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	req.Header = cfg.Request.Header.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+
+	var bodyBytes []byte
+	if body != nil {
+		bodyBytes, err = json.Marshal(body)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if method == http.MethodPost {
+		if cfg.IdempotencyKey == "" {
+			cfg.IdempotencyKey, err = newIdempotencyKey()
+			if err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		req.Header.Set("Idempotency-Key", cfg.IdempotencyKey)
+
+		if cfg.CreditBudgetHook != nil {
+			if err := cfg.CreditBudgetHook(cfg); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+
+	return cfg, req, bodyBytes, nil
+}
+
+func executeWithRetry(cfg *RequestConfig, req *http.Request, bodyBytes []byte, res any) error {
+	httpRes, err := doWithRetry(cfg, req, bodyBytes)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+	if res != nil {
+		return json.NewDecoder(httpRes.Body).Decode(res)
+	}
+	return nil
+}
+
+// doWithRetry sends req, retrying according to cfg.RetryPolicy, and returns
+// the first response accepted by the policy. The caller owns the returned
+// response's body and must close it.
+func doWithRetry(cfg *RequestConfig, req *http.Request, bodyBytes []byte) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt <= cfg.MaxRetries; attempt++ {
+		if bodyBytes != nil {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+			req.ContentLength = int64(len(bodyBytes))
+		}
+
+		httpRes, err := cfg.HTTPClient.Do(req)
+		if err == nil && httpRes.StatusCode < 400 {
+			if cfg.CreditObserverHook != nil {
+				cfg.CreditObserverHook(cfg, httpRes)
+			}
+			return httpRes, nil
+		}
+
+		retry := cfg.RetryPolicy != nil && cfg.RetryPolicy(httpRes, err)
+		if httpRes != nil {
+			httpRes.Body.Close()
+		}
+		if err != nil {
+			lastErr = err
+		} else {
+			lastErr = fmt.Errorf("unexpected status code %d", httpRes.StatusCode)
+		}
+		if !retry || attempt == cfg.MaxRetries {
+			if cfg.CreditReleaseHook != nil {
+				cfg.CreditReleaseHook(cfg)
+			}
+			return nil, lastErr
+		}
+
+		delay := retryDelay(cfg, httpRes, attempt)
+		select {
+		case <-req.Context().Done():
+			if cfg.CreditReleaseHook != nil {
+				cfg.CreditReleaseHook(cfg)
+			}
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+	return nil, lastErr
+}
+
+// retryDelay computes how long to wait before the next attempt, honoring a
+// Retry-After header (either delta-seconds or an HTTP-date) when the server
+// sent one, and otherwise falling back to exponential backoff with jitter.
+func retryDelay(cfg *RequestConfig, res *http.Response, attempt int) time.Duration {
+	if res != nil {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	backoff := float64(cfg.MinRetryDelay) * math.Pow(2, float64(attempt))
+	if max := float64(cfg.MaxRetryDelay); backoff > max {
+		backoff = max
+	}
+	jitter := backoff * (0.5 + mathrand.Float64()/2)
+	return time.Duration(jitter)
+}
+
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+	if when, err := http.ParseTime(header); err == nil {
+		return time.Until(when), true
+	}
+	return 0, false
+}
+
+func newIdempotencyKey() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // variant 10
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,92 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package requestconfig
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+		wantOk bool
+	}{
+		{name: "empty", header: "", wantOk: false},
+		{name: "delta seconds", header: "5", want: 5 * time.Second, wantOk: true},
+		{name: "zero seconds", header: "0", want: 0, wantOk: true},
+		{name: "http date", header: time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat), want: 2 * time.Second, wantOk: true},
+		{name: "garbage", header: "not-a-valid-value", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.header)
+			if ok != tt.wantOk {
+				t.Fatalf("parseRetryAfter(%q) ok = %v, want %v", tt.header, ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			// HTTP-date has only second-level precision, so allow a small skew.
+			if d := got - tt.want; d < -time.Second || d > time.Second {
+				t.Fatalf("parseRetryAfter(%q) = %v, want ~%v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelayHonorsRetryAfterHeader(t *testing.T) {
+	// retryDelay should prefer a Retry-After header over the exponential
+	// backoff schedule.
+	cfg := &RequestConfig{MinRetryDelay: time.Hour, MaxRetryDelay: time.Hour}
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"0"}}}
+	if d := retryDelay(cfg, res, 0); d != 0 {
+		t.Fatalf("retryDelay() = %v, want 0 (from Retry-After)", d)
+	}
+}
+
+func TestRetryDelayFallsBackToBackoff(t *testing.T) {
+	cfg := &RequestConfig{MinRetryDelay: 10 * time.Millisecond, MaxRetryDelay: time.Second}
+	d := retryDelay(cfg, nil, 0)
+	if d <= 0 || d > cfg.MaxRetryDelay {
+		t.Fatalf("retryDelay() = %v, want a positive value capped at %v", d, cfg.MaxRetryDelay)
+	}
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		err    error
+		want   bool
+	}{
+		{name: "network error", err: errInjected, want: true},
+		{name: "408", status: http.StatusRequestTimeout, want: true},
+		{name: "429", status: http.StatusTooManyRequests, want: true},
+		{name: "500", status: http.StatusInternalServerError, want: true},
+		{name: "404", status: http.StatusNotFound, want: false},
+		{name: "200", status: http.StatusOK, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var res *http.Response
+			if tt.status != 0 {
+				res = &http.Response{StatusCode: tt.status}
+			}
+			if got := DefaultRetryPolicy(res, tt.err); got != tt.want {
+				t.Fatalf("DefaultRetryPolicy(%+v, %v) = %v, want %v", res, tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+var errInjected = &testError{"injected"}
+
+type testError struct{ msg string }
+
+func (e *testError) Error() string { return e.msg }
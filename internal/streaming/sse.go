@@ -0,0 +1,120 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+// Package streaming implements a minimal Server-Sent Events decoder, shared
+// by every service that exposes a streaming method (starting with
+// [SmartcrawlerService.NewStreaming]).
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Event is a single decoded SSE message.
+type Event struct {
+	// ID, if present, is used as the Last-Event-ID on reconnection.
+	ID string
+	// Name is the value of the "event:" field, or "" if the server omitted it.
+	Name string
+	// Data is the concatenation of every "data:" line in the message, joined
+	// by newlines, with the trailing newline each field contributes removed.
+	Data []byte
+	// Retry is the reconnection delay requested by a "retry:" field, or 0 if
+	// the server did not send one.
+	Retry time.Duration
+}
+
+// Decoder reads Server-Sent Events from an underlying stream, one message at
+// a time.
+type Decoder struct {
+	scanner *bufio.Scanner
+	closer  io.Closer
+	cur     Event
+	err     error
+}
+
+// NewDecoder wraps r, decoding SSE messages from it. If r also implements
+// io.Closer, Decoder.Close closes it.
+func NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var closer io.Closer
+	if c, ok := r.(io.Closer); ok {
+		closer = c
+	}
+	return &Decoder{scanner: scanner, closer: closer}
+}
+
+// Next advances to the next event, returning false once the stream ends or
+// an error occurs. Check Err after Next returns false to distinguish the
+// two.
+func (d *Decoder) Next() bool {
+	var data bytes.Buffer
+	var ev Event
+	sawField := false
+
+	for d.scanner.Scan() {
+		line := d.scanner.Text()
+		if line == "" {
+			if !sawField {
+				continue
+			}
+			ev.Data = bytes.TrimSuffix(data.Bytes(), []byte("\n"))
+			d.cur = ev
+			return true
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		if field == "" {
+			// A line starting with ":" is a comment (commonly used as a
+			// keep-alive) and must not affect dispatch.
+			continue
+		}
+		sawField = true
+
+		switch field {
+		case "event":
+			ev.Name = value
+		case "data":
+			data.WriteString(value)
+			data.WriteByte('\n')
+		case "id":
+			ev.ID = value
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil {
+				ev.Retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+
+	if err := d.scanner.Err(); err != nil {
+		d.err = err
+		return false
+	}
+	if sawField {
+		ev.Data = bytes.TrimSuffix(data.Bytes(), []byte("\n"))
+		d.cur = ev
+		return true
+	}
+	return false
+}
+
+// Event returns the event most recently decoded by Next.
+func (d *Decoder) Event() Event { return d.cur }
+
+// Err returns the first non-EOF error encountered while reading, if any.
+func (d *Decoder) Err() error { return d.err }
+
+// Close closes the underlying stream, if it is closeable.
+func (d *Decoder) Close() error {
+	if d.closer != nil {
+		return d.closer.Close()
+	}
+	return nil
+}
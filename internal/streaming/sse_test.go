@@ -0,0 +1,64 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package streaming
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecoderNext(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Event
+		wantErr bool
+	}{
+		{
+			name:  "single event",
+			input: "data: hello\n\n",
+			want:  []Event{{Data: []byte("hello")}},
+		},
+		{
+			name:  "id and event name",
+			input: "id: 1\nevent: page_fetched\ndata: {}\n\n",
+			want:  []Event{{ID: "1", Name: "page_fetched", Data: []byte("{}")}},
+		},
+		{
+			name:  "multi-line data is newline joined",
+			input: "data: line one\ndata: line two\n\n",
+			want:  []Event{{Data: []byte("line one\nline two")}},
+		},
+		{
+			name:  "keep-alive comment is ignored, not dispatched as an empty event",
+			input: ": keep-alive\n\ndata: after\n\n",
+			want:  []Event{{Data: []byte("after")}},
+		},
+		{
+			name:  "comment between fields of the same event is ignored",
+			input: "data: hello\n: keep-alive\ndata: world\n\n",
+			want:  []Event{{Data: []byte("hello\nworld")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec := NewDecoder(strings.NewReader(tt.input))
+			var got []Event
+			for dec.Next() {
+				got = append(got, dec.Event())
+			}
+			if err := dec.Err(); (err != nil) != tt.wantErr {
+				t.Fatalf("Err() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d events, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i, ev := range got {
+				if ev.ID != tt.want[i].ID || ev.Name != tt.want[i].Name || string(ev.Data) != string(tt.want[i].Data) {
+					t.Errorf("event %d = %+v, want %+v", i, ev, tt.want[i])
+				}
+			}
+		})
+	}
+}
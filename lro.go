@@ -0,0 +1,234 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// PollOption configures how a [Poller] waits for a long-running operation to
+// finish.
+type PollOption func(*pollConfig)
+
+type pollConfig struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+	jitter          float64
+	deadline        time.Duration
+	isTerminal      func(status string) bool
+}
+
+func defaultPollConfig() *pollConfig {
+	return &pollConfig{
+		initialInterval: 500 * time.Millisecond,
+		maxInterval:     10 * time.Second,
+		multiplier:      1.5,
+		jitter:          0.1,
+		deadline:        5 * time.Minute,
+		isTerminal: func(status string) bool {
+			switch status {
+			case "completed", "failed", "cancelled":
+				return true
+			default:
+				return false
+			}
+		},
+	}
+}
+
+// WithPollInitialInterval sets the delay before the first re-poll. Defaults
+// to 500ms.
+func WithPollInitialInterval(d time.Duration) PollOption {
+	return func(c *pollConfig) { c.initialInterval = d }
+}
+
+// WithPollMaxInterval caps how large the exponential backoff between polls
+// can grow. Defaults to 10s.
+func WithPollMaxInterval(d time.Duration) PollOption {
+	return func(c *pollConfig) { c.maxInterval = d }
+}
+
+// WithPollBackoffMultiplier sets the exponential backoff multiplier applied
+// after every poll. Defaults to 1.5.
+func WithPollBackoffMultiplier(m float64) PollOption {
+	return func(c *pollConfig) { c.multiplier = m }
+}
+
+// WithPollJitter sets the fraction of the computed interval to randomly vary
+// by, to avoid many pollers waking up in lockstep. Defaults to 0.1 (10%).
+func WithPollJitter(j float64) PollOption {
+	return func(c *pollConfig) { c.jitter = j }
+}
+
+// WithPollDeadline bounds how long [Poller.Wait] will keep polling before
+// giving up with a context.DeadlineExceeded error. Defaults to 5 minutes.
+func WithPollDeadline(d time.Duration) PollOption {
+	return func(c *pollConfig) { c.deadline = d }
+}
+
+// WithPollTerminalStates overrides the set of status values that stop
+// polling. Defaults to "completed", "failed", and "cancelled".
+func WithPollTerminalStates(statuses ...string) PollOption {
+	return func(c *pollConfig) {
+		set := make(map[string]bool, len(statuses))
+		for _, status := range statuses {
+			set[status] = true
+		}
+		c.isTerminal = func(status string) bool { return set[status] }
+	}
+}
+
+// Poller drives a long-running operation to completion by repeatedly
+// fetching its status until it reaches a terminal state. Every async
+// service in this SDK (for example [MarkdownifyService]) exposes a NewAndWait
+// method built on top of a Poller, but it can also be used directly when
+// more control over the polling schedule is needed.
+type Poller[T any] struct {
+	requestID string
+	fetch     func(ctx context.Context, requestID string) (res T, status string, err error)
+	cfg       *pollConfig
+	done      bool
+}
+
+// NewPoller starts a poller for the operation identified by requestID. fetch
+// is invoked on every poll and must return the current status alongside the
+// typed result.
+func NewPoller[T any](requestID string, fetch func(ctx context.Context, requestID string) (T, string, error), opts ...PollOption) *Poller[T] {
+	cfg := defaultPollConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return &Poller[T]{requestID: requestID, fetch: fetch, cfg: cfg}
+}
+
+// ResumePoller continues polling an operation identified by requestID from a
+// fresh process, e.g. after persisting the ID returned by an earlier New
+// call so that polling can continue elsewhere.
+func ResumePoller[T any](requestID string, fetch func(ctx context.Context, requestID string) (T, string, error), opts ...PollOption) *Poller[T] {
+	return NewPoller(requestID, fetch, opts...)
+}
+
+// RequestID returns the identifier of the operation being polled.
+func (p *Poller[T]) RequestID() string { return p.requestID }
+
+// Done reports whether the most recent call to Poll observed a terminal
+// status.
+func (p *Poller[T]) Done() bool { return p.done }
+
+// Poll performs a single fetch and reports whether the operation has reached
+// a terminal status.
+func (p *Poller[T]) Poll(ctx context.Context) (done bool, res T, err error) {
+	res, status, err := p.fetch(ctx, p.requestID)
+	if err != nil {
+		return false, res, err
+	}
+	p.done = p.cfg.isTerminal(status)
+	return p.done, res, nil
+}
+
+// Wait repeatedly calls Poll, backing off exponentially between attempts,
+// until the operation reaches a terminal status, the context is cancelled,
+// or the configured deadline elapses, whichever happens first.
+func (p *Poller[T]) Wait(ctx context.Context) (res T, err error) {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.deadline)
+	defer cancel()
+
+	interval := p.cfg.initialInterval
+	for {
+		done, polled, err := p.Poll(ctx)
+		if err != nil {
+			return polled, err
+		}
+		if done {
+			return polled, nil
+		}
+
+		wait := interval
+		if p.cfg.jitter > 0 {
+			delta := time.Duration(float64(wait) * p.cfg.jitter)
+			wait += time.Duration(rand.Int63n(int64(2*delta+1))) - delta
+		}
+
+		select {
+		case <-ctx.Done():
+			return polled, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		interval = time.Duration(float64(interval) * p.cfg.multiplier)
+		if interval > p.cfg.maxInterval {
+			interval = p.cfg.maxInterval
+		}
+	}
+}
+
+// NewAndWait submits a markdownify job and polls [MarkdownifyService.Get]
+// until it completes, fails, or is cancelled.
+func (r *MarkdownifyService) NewAndWait(ctx context.Context, body MarkdownifyNewParams, opts ...PollOption) (*MarkdownifyGetResponse, error) {
+	created, err := r.New(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	poller := NewPoller(created.RequestID, func(ctx context.Context, requestID string) (*MarkdownifyGetResponse, string, error) {
+		res, err := r.Get(ctx, requestID)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, res.Status, nil
+	}, opts...)
+	return poller.Wait(ctx)
+}
+
+// NewAndWait submits a searchscraper job and polls [SearchscraperService.Get]
+// until it completes, fails, or is cancelled.
+func (r *SearchscraperService) NewAndWait(ctx context.Context, body SearchscraperNewParams, opts ...PollOption) (*SearchscraperGetResponse, error) {
+	created, err := r.New(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	poller := NewPoller(created.RequestID, func(ctx context.Context, requestID string) (*SearchscraperGetResponse, string, error) {
+		res, err := r.Get(ctx, requestID)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, res.Status, nil
+	}, opts...)
+	return poller.Wait(ctx)
+}
+
+// NewAndWait submits a generate_schema job and polls [GenerateSchemaService.Get]
+// until it completes, fails, or is cancelled.
+func (r *GenerateSchemaService) NewAndWait(ctx context.Context, body GenerateSchemaNewParams, opts ...PollOption) (*GenerateSchemaGetResponse, error) {
+	created, err := r.New(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	poller := NewPoller(created.RequestID, func(ctx context.Context, requestID string) (*GenerateSchemaGetResponse, string, error) {
+		res, err := r.Get(ctx, requestID)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, res.Status, nil
+	}, opts...)
+	return poller.Wait(ctx)
+}
+
+// NewAndWait submits a smartcrawler job and polls [SmartcrawlerService.Get]
+// until it completes, fails, or is cancelled.
+func (r *SmartcrawlerService) NewAndWait(ctx context.Context, body SmartcrawlerNewParams, opts ...PollOption) (*SmartcrawlerGetResponse, error) {
+	created, err := r.New(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	poller := NewPoller(created.SessionID, func(ctx context.Context, requestID string) (*SmartcrawlerGetResponse, string, error) {
+		res, err := r.Get(ctx, requestID)
+		if err != nil {
+			return nil, "", err
+		}
+		return res, res.Status, nil
+	}, opts...)
+	return poller.Wait(ctx)
+}
@@ -0,0 +1,88 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	scrapegraphaisdk "github.com/ScrapeGraphAI/scrapegraph-sdk"
+)
+
+func TestPollerWait(t *testing.T) {
+	var calls int
+	fetch := func(ctx context.Context, requestID string) (int, string, error) {
+		calls++
+		if calls < 3 {
+			return 0, "processing", nil
+		}
+		return 42, "completed", nil
+	}
+
+	poller := scrapegraphaisdk.NewPoller("req-1", fetch,
+		scrapegraphaisdk.WithPollInitialInterval(time.Millisecond),
+		scrapegraphaisdk.WithPollMaxInterval(time.Millisecond),
+	)
+	res, err := poller.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait() err = %v", err)
+	}
+	if res != 42 {
+		t.Fatalf("Wait() res = %d, want 42", res)
+	}
+	if calls != 3 {
+		t.Fatalf("fetch called %d times, want 3", calls)
+	}
+	if !poller.Done() {
+		t.Fatal("Done() = false after a terminal status")
+	}
+}
+
+func TestPollerWaitPropagatesFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(ctx context.Context, requestID string) (int, string, error) {
+		return 0, "", wantErr
+	}
+
+	poller := scrapegraphaisdk.NewPoller("req-1", fetch, scrapegraphaisdk.WithPollInitialInterval(time.Millisecond))
+	_, err := poller.Wait(context.Background())
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Wait() err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestPollerWaitRespectsDeadline(t *testing.T) {
+	fetch := func(ctx context.Context, requestID string) (int, string, error) {
+		return 0, "processing", nil
+	}
+
+	poller := scrapegraphaisdk.NewPoller("req-1", fetch,
+		scrapegraphaisdk.WithPollInitialInterval(time.Millisecond),
+		scrapegraphaisdk.WithPollMaxInterval(time.Millisecond),
+		scrapegraphaisdk.WithPollDeadline(10*time.Millisecond),
+	)
+	_, err := poller.Wait(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait() err = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestPollerWaitCustomTerminalStates(t *testing.T) {
+	fetch := func(ctx context.Context, requestID string) (int, string, error) {
+		return 7, "partial", nil
+	}
+
+	poller := scrapegraphaisdk.NewPoller("req-1", fetch,
+		scrapegraphaisdk.WithPollInitialInterval(time.Millisecond),
+		scrapegraphaisdk.WithPollTerminalStates("partial"),
+	)
+	res, err := poller.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Wait() err = %v", err)
+	}
+	if res != 7 {
+		t.Fatalf("Wait() res = %d, want 7", res)
+	}
+}
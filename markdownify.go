@@ -10,6 +10,7 @@ import (
 
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
 )
 
 // MarkdownifyService contains methods and other services that help with
@@ -32,23 +33,58 @@ func NewMarkdownifyService(opts ...option.RequestOption) (r MarkdownifyService)
 }
 
 // POST /markdownify
-func (r *MarkdownifyService) New(ctx context.Context, opts ...option.RequestOption) (err error) {
+func (r *MarkdownifyService) New(ctx context.Context, body MarkdownifyNewParams, opts ...option.RequestOption) (res *MarkdownifyNewResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	path := "v1/markdownify"
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
 }
 
 // GET /markdownify/{request_id}
-func (r *MarkdownifyService) Get(ctx context.Context, requestID string, opts ...option.RequestOption) (err error) {
+func (r *MarkdownifyService) Get(ctx context.Context, requestID string, opts ...option.RequestOption) (res *MarkdownifyGetResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	if requestID == "" {
 		err = errors.New("missing required request_id parameter")
 		return
 	}
 	path := fmt.Sprintf("v1/markdownify/%s", requestID)
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
+
+// MarkdownifyNewParams is the request body for [MarkdownifyService.New].
+type MarkdownifyNewParams struct {
+	// URL is the page to convert to markdown.
+	URL string `json:"url"`
+	// IncludeLinks controls whether hyperlinks are preserved in the converted
+	// markdown. The server defaults to true when this is omitted.
+	IncludeLinks param.Opt[bool] `json:"include_links,omitzero"`
+	// Headers are extra HTTP headers sent to the target page while fetching it,
+	// useful for cookies or a custom user agent.
+	Headers map[string]string `json:"headers,omitzero"`
+	paramObj
+}
+
+func (r MarkdownifyNewParams) MarshalJSON() (data []byte, err error) {
+	type shadow MarkdownifyNewParams
+	return param.MarshalObject(r, (*shadow)(&r))
+}
+
+// MarkdownifyNewResponse is returned from [MarkdownifyService.New].
+type MarkdownifyNewResponse struct {
+	// RequestID can be passed to [MarkdownifyService.Get] to poll for the result.
+	RequestID string `json:"request_id"`
+	Status    string `json:"status"`
+}
+
+// MarkdownifyGetResponse is returned from [MarkdownifyService.Get].
+type MarkdownifyGetResponse struct {
+	RequestID string `json:"request_id"`
+	// Status is one of "queued", "processing", "completed", "failed", or
+	// "cancelled".
+	Status string `json:"status"`
+	// Markdown is only populated once Status is "completed".
+	Markdown string `json:"markdown"`
+	// Error is only populated once Status is "failed".
+	Error string `json:"error"`
+}
@@ -0,0 +1,239 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package option
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
+)
+
+// CreditEstimator predicts how many credits a request will cost, given its
+// HTTP method and path, so [WithCreditBudget] can decide whether to allow it
+// before it is sent.
+type CreditEstimator interface {
+	EstimateCost(method, path string) int64
+}
+
+// CreditEstimatorFunc adapts a plain function to a [CreditEstimator].
+type CreditEstimatorFunc func(method, path string) int64
+
+// EstimateCost calls f.
+func (f CreditEstimatorFunc) EstimateCost(method, path string) int64 { return f(method, path) }
+
+// ErrInsufficientCredits is returned (or, under [WarnOnly], only logged)
+// when a request's estimated cost would exceed the cached credit balance.
+type ErrInsufficientCredits struct {
+	Required  int64
+	Available int64
+}
+
+func (e *ErrInsufficientCredits) Error() string {
+	return fmt.Sprintf("insufficient credits: request needs %d, %d available", e.Required, e.Available)
+}
+
+// BudgetPolicy decides what happens when a request's estimated cost would
+// exceed the cached credit balance maintained by [WithCreditBudget]. Use
+// [HardStop], [WarnOnly], or [ReserveAndRelease].
+type BudgetPolicy interface {
+	enforce(required, available int64) error
+	reserves() bool
+	// blockOnRefreshError reports whether a failure to refresh the cached
+	// balance should itself reject the request, rather than falling back to
+	// the stale balance.
+	blockOnRefreshError() bool
+}
+
+// requireAvailable is the shared enforcement rule behind [HardStop] and
+// [ReserveAndRelease]: reject whenever the estimated cost exceeds what's
+// available.
+func requireAvailable(required, available int64) error {
+	if required > available {
+		return &ErrInsufficientCredits{Required: required, Available: available}
+	}
+	return nil
+}
+
+type hardStopPolicy struct{}
+
+// HardStop rejects a request with [ErrInsufficientCredits] whenever its
+// estimated cost would exceed the cached balance.
+func HardStop() BudgetPolicy { return hardStopPolicy{} }
+
+func (hardStopPolicy) enforce(required, available int64) error {
+	return requireAvailable(required, available)
+}
+
+func (hardStopPolicy) reserves() bool            { return false }
+func (hardStopPolicy) blockOnRefreshError() bool { return true }
+
+type warnOnlyPolicy struct{ logger *slog.Logger }
+
+// WarnOnly never blocks a request, but logs a warning via logger whenever
+// its estimated cost would have exceeded the cached balance.
+func WarnOnly(logger *slog.Logger) BudgetPolicy { return warnOnlyPolicy{logger: logger} }
+
+func (p warnOnlyPolicy) enforce(required, available int64) error {
+	if required > available {
+		p.logger.Warn("request exceeds cached credit budget", "required", required, "available", available)
+	}
+	return nil
+}
+
+func (warnOnlyPolicy) reserves() bool            { return false }
+func (warnOnlyPolicy) blockOnRefreshError() bool { return false }
+
+type reserveAndReleasePolicy struct{}
+
+// ReserveAndRelease atomically reserves a request's estimated cost against
+// the cached balance before it is sent, then releases the unused delta once
+// the server reports the actual charge via a response's credit headers.
+func ReserveAndRelease() BudgetPolicy { return reserveAndReleasePolicy{} }
+
+func (reserveAndReleasePolicy) enforce(required, available int64) error {
+	return requireAvailable(required, available)
+}
+
+func (reserveAndReleasePolicy) reserves() bool            { return true }
+func (reserveAndReleasePolicy) blockOnRefreshError() bool { return true }
+
+// billablePaths holds the request paths (as passed to
+// requestconfig.ExecuteNewRequest, not the full URL) of the operations that
+// actually draw down credits. Every other POST, such as Feedback.New, is
+// left alone by [WithCreditBudget].
+var billablePaths = map[string]bool{
+	"v1/markdownify":   true,
+	"v1/searchscraper": true,
+	"smartcrawler":     true,
+	"generate_schema":  true,
+}
+
+// creditBudget is the shared, mutable state behind a single [WithCreditBudget]
+// option: every request made through a client configured with it consults,
+// and may update, the same cached balance.
+type creditBudget struct {
+	policy    BudgetPolicy
+	estimator CreditEstimator
+	ttl       time.Duration
+
+	mu        sync.Mutex
+	balance   int64
+	fetchedAt time.Time
+	reserved  int64
+}
+
+// WithCreditBudget caps spend across every billable request (Markdownify.New,
+// Searchscraper.New, Smartcrawler.New, and GenerateSchema.New) made through a
+// client, without having to check [CreditService.List] by hand at every call
+// site. Non-billable requests, such as Feedback.New, are never consulted or
+// charged against the budget. The cached balance is refreshed whenever it is
+// older than ttl, and again whenever a response reports an updated balance
+// via its X-Remaining-Credits header.
+func WithCreditBudget(policy BudgetPolicy, estimator CreditEstimator, ttl time.Duration) RequestOption {
+	budget := &creditBudget{policy: policy, estimator: estimator, ttl: ttl}
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.CreditBudgetHook = budget.beforeRequest
+		cfg.CreditObserverHook = budget.observeResponse
+		cfg.CreditReleaseHook = budget.releaseReservation
+		return nil
+	}
+}
+
+func (b *creditBudget) beforeRequest(cfg *requestconfig.RequestConfig) error {
+	if !billablePaths[cfg.Request.URL.Path] {
+		return nil
+	}
+
+	b.mu.Lock()
+	stale := time.Since(b.fetchedAt) > b.ttl
+	b.mu.Unlock()
+
+	// fetchCreditBalance makes a network round-trip; do it without holding
+	// mu so it doesn't serialize every other request sharing this budget.
+	if stale {
+		balance, err := fetchCreditBalance(cfg)
+		if err != nil && b.policy.blockOnRefreshError() {
+			return err
+		}
+		if err == nil {
+			b.mu.Lock()
+			b.balance, b.fetchedAt = balance, time.Now()
+			b.mu.Unlock()
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	required := b.estimator.EstimateCost(cfg.Request.Method, cfg.Request.URL.Path)
+	available := b.balance - b.reserved
+	if err := b.policy.enforce(required, available); err != nil {
+		return err
+	}
+	if b.policy.reserves() {
+		b.reserved += required
+		cfg.CreditReserved = required
+	}
+	return nil
+}
+
+func (b *creditBudget) observeResponse(cfg *requestconfig.RequestConfig, res *http.Response) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.policy.reserves() && cfg.CreditReserved > 0 {
+		b.reserved -= cfg.CreditReserved
+		if b.reserved < 0 {
+			b.reserved = 0
+		}
+		cfg.CreditReserved = 0
+	}
+
+	header := res.Header.Get("X-Remaining-Credits")
+	if header == "" {
+		return
+	}
+	remaining, err := strconv.ParseInt(header, 10, 64)
+	if err != nil {
+		return
+	}
+	b.balance, b.fetchedAt = remaining, time.Now()
+}
+
+// releaseReservation rolls back the reservation beforeRequest made for cfg
+// when the request ultimately fails, since observeResponse only runs for a
+// successful response. Without this, a reservation that's never charged
+// permanently shrinks the cached balance.
+func (b *creditBudget) releaseReservation(cfg *requestconfig.RequestConfig) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.policy.reserves() && cfg.CreditReserved > 0 {
+		b.reserved -= cfg.CreditReserved
+		if b.reserved < 0 {
+			b.reserved = 0
+		}
+		cfg.CreditReserved = 0
+	}
+}
+
+// fetchCreditBalance performs a minimal GET of v1/credits using cfg's base
+// URL and authentication, independent of CreditService to avoid this package
+// importing the root module package.
+func fetchCreditBalance(cfg *requestconfig.RequestConfig) (int64, error) {
+	var body struct {
+		RemainingCredits int64 `json:"remaining_credits"`
+	}
+	opts := []RequestOption{
+		WithBaseURL(cfg.BaseURL),
+		WithHeader("Authorization", cfg.Request.Header.Get("Authorization")),
+	}
+	if err := requestconfig.ExecuteNewRequest(cfg.Context, http.MethodGet, "v1/credits", nil, &body, opts...); err != nil {
+		return 0, err
+	}
+	return body.RemainingCredits, nil
+}
@@ -0,0 +1,121 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package option
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
+)
+
+func TestCreditBudgetReleasesReservationOnFailure(t *testing.T) {
+	estimator := CreditEstimatorFunc(func(method, path string) int64 { return 10 })
+	budget := &creditBudget{
+		policy:    ReserveAndRelease(),
+		estimator: estimator,
+		ttl:       time.Hour,
+		balance:   100,
+		fetchedAt: time.Now(),
+	}
+
+	cfg := testRequestConfig(t, http.MethodPost, "v1/searchscraper")
+	if err := budget.beforeRequest(cfg); err != nil {
+		t.Fatalf("beforeRequest() err = %v", err)
+	}
+	if budget.reserved != 10 {
+		t.Fatalf("reserved = %d, want 10", budget.reserved)
+	}
+
+	// observeResponse is only reachable on a successful response; a request
+	// that ultimately fails must still have its reservation released via
+	// CreditReleaseHook, or it permanently shrinks the cached balance.
+	budget.releaseReservation(cfg)
+	if budget.reserved != 0 {
+		t.Fatalf("reserved after release = %d, want 0", budget.reserved)
+	}
+
+	cfg2 := testRequestConfig(t, http.MethodPost, "v1/searchscraper")
+	if err := budget.beforeRequest(cfg2); err != nil {
+		t.Fatalf("beforeRequest() after release err = %v", err)
+	}
+}
+
+func testRequestConfig(t *testing.T, method, path string) *requestconfig.RequestConfig {
+	t.Helper()
+	cfg, err := requestconfig.NewRequestConfig(context.Background(), method, path, nil, nil)
+	if err != nil {
+		t.Fatalf("NewRequestConfig() err = %v", err)
+	}
+	return cfg
+}
+
+// TestWithCreditBudgetReleasesOnFailedRequest drives repeated failing
+// requests through the real retry path and asserts that a leaked
+// reservation never accumulates enough to make ReserveAndRelease start
+// rejecting requests with a bogus ErrInsufficientCredits.
+func TestWithCreditBudgetReleasesOnFailedRequest(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/credits", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"remaining_credits":100}`)
+	})
+	mux.HandleFunc("/v1/searchscraper", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	estimator := CreditEstimatorFunc(func(method, path string) int64 { return 10 })
+	opts := []requestconfig.RequestOption{
+		WithBaseURL(srv.URL + "/"),
+		WithCreditBudget(ReserveAndRelease(), estimator, time.Hour),
+		WithMaxRetries(0),
+	}
+
+	// Enough failing requests that a leaked 10-credit reservation each time
+	// would exceed the 100-credit balance well before the loop ends.
+	for i := 0; i < 15; i++ {
+		var res any
+		err := requestconfig.ExecuteNewRequest(context.Background(), http.MethodPost, "v1/searchscraper", map[string]any{}, &res, opts...)
+		if err == nil {
+			t.Fatalf("request %d: expected an error from the 500 response", i)
+		}
+		var budgetErr *ErrInsufficientCredits
+		if errors.As(err, &budgetErr) {
+			t.Fatalf("request %d rejected by a leaked reservation: %v", i, err)
+		}
+	}
+}
+
+// TestWithCreditBudgetIgnoresNonBillableRequests asserts that a request like
+// Feedback.New, which isn't one of the billable operations WithCreditBudget
+// is documented to cover, is never rejected by the budget even when it's
+// exhausted.
+func TestWithCreditBudgetIgnoresNonBillableRequests(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/credits", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"remaining_credits":0}`)
+	})
+	mux.HandleFunc("/v1/feedback", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success":true}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	estimator := CreditEstimatorFunc(func(method, path string) int64 { return 10 })
+	opts := []requestconfig.RequestOption{
+		WithBaseURL(srv.URL + "/"),
+		WithCreditBudget(HardStop(), estimator, time.Hour),
+	}
+
+	var res any
+	err := requestconfig.ExecuteNewRequest(context.Background(), http.MethodPost, "v1/feedback", map[string]any{}, &res, opts...)
+	if err != nil {
+		t.Fatalf("ExecuteNewRequest() err = %v, want nil", err)
+	}
+}
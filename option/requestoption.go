@@ -0,0 +1,96 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+// Package option contains [RequestOption]s that can be passed to a client or
+// service constructor, or to an individual request, to customize the HTTP
+// request that gets sent.
+package option
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
+)
+
+// RequestOption mutates a request before it is sent. It is returned by every
+// With* helper in this package.
+type RequestOption = requestconfig.RequestOption
+
+// WithBaseURL changes the base URL requests are made against, replacing the
+// default API base URL.
+func WithBaseURL(baseURL string) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.BaseURL = baseURL
+		return nil
+	}
+}
+
+// WithAPIKey sets the API key used to authenticate requests.
+func WithAPIKey(apiKey string) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.APIKey = apiKey
+		cfg.Request.Header.Set("Authorization", "Bearer "+apiKey)
+		return nil
+	}
+}
+
+// WithHTTPClient overrides the http.Client used to send requests.
+func WithHTTPClient(client *http.Client) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.HTTPClient = client
+		return nil
+	}
+}
+
+// WithHeader sets a header on the request, overwriting any existing values
+// for that key. Passing an empty value removes the header instead.
+func WithHeader(key, value string) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		if value == "" {
+			cfg.Request.Header.Del(key)
+			return nil
+		}
+		cfg.Request.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithMaxRetries sets the maximum number of times a request will be retried
+// after a retryable failure, not counting the initial attempt. Defaults to 2.
+func WithMaxRetries(n int) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.MaxRetries = n
+		return nil
+	}
+}
+
+// WithRetryPolicy overrides the predicate used to decide whether a response
+// or error should be retried. See [requestconfig.DefaultRetryPolicy] for the
+// default behavior.
+func WithRetryPolicy(policy requestconfig.RetryPolicy) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.RetryPolicy = policy
+		return nil
+	}
+}
+
+// WithBackoff sets the minimum and maximum delay between retries. The actual
+// delay grows exponentially between these bounds, with jitter, unless the
+// server supplies a Retry-After header.
+func WithBackoff(min, max time.Duration) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.MinRetryDelay = min
+		cfg.MaxRetryDelay = max
+		return nil
+	}
+}
+
+// WithIdempotencyKey attaches an explicit Idempotency-Key header to a
+// request, instead of the random key that is otherwise generated
+// automatically for POST requests.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(cfg *requestconfig.RequestConfig) error {
+		cfg.IdempotencyKey = key
+		return nil
+	}
+}
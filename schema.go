@@ -0,0 +1,230 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
+)
+
+// SchemaMismatchError reports that a scrape or search result did not match
+// the JSON Schema derived from the struct passed to [Extract] or [Search].
+type SchemaMismatchError struct {
+	// Path is a JSON-pointer-like location of the mismatch, e.g. "$.price".
+	Path string
+	Err  error
+}
+
+func (e *SchemaMismatchError) Error() string {
+	return fmt.Sprintf("schema mismatch at %s: %s", e.Path, e.Err)
+}
+
+func (e *SchemaMismatchError) Unwrap() error { return e.Err }
+
+var promptCache sync.Map // map[reflect.Type]string
+
+// promptFor derives a GenerateSchemaNewParams.UserPrompt describing T's
+// fields, keyed by each field's `scrape` struct tag (falling back to the
+// lowercased field name) and described by its `desc` struct tag. Prompts are
+// cached per type so repeated calls for the same T are free.
+func promptFor(t reflect.Type) string {
+	if cached, ok := promptCache.Load(t); ok {
+		return cached.(string)
+	}
+	prompt := "Extract the following fields: " + strings.Join(fieldDescriptions(t), ", ")
+	promptCache.Store(t, prompt)
+	return prompt
+}
+
+func fieldDescriptions(t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		return fieldDescriptions(t.Elem())
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Tag.Get("scrape")
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		if desc := field.Tag.Get("desc"); desc != "" {
+			fields = append(fields, fmt.Sprintf("%s (%s)", name, desc))
+		} else {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
+
+// validateSchema checks that value (as decoded by encoding/json, i.e. built
+// from map[string]any, []any, string, float64, bool, and nil) matches the
+// shape of schema, returning a [SchemaMismatchError] describing the first
+// mismatch found.
+func validateSchema(path string, schema map[string]any, value any) error {
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return &SchemaMismatchError{Path: path, Err: fmt.Errorf("expected object, got %T", value)}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for _, name := range requiredFields(schema) {
+			fieldValue, present := obj[name]
+			if !present {
+				return &SchemaMismatchError{Path: path + "." + name, Err: errors.New("missing required field")}
+			}
+			if fieldSchema, ok := properties[name].(map[string]any); ok {
+				if err := validateSchema(path+"."+name, fieldSchema, fieldValue); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return &SchemaMismatchError{Path: path, Err: fmt.Errorf("expected array, got %T", value)}
+		}
+		items, _ := schema["items"].(map[string]any)
+		for i, elem := range arr {
+			if err := validateSchema(fmt.Sprintf("%s[%d]", path, i), items, elem); err != nil {
+				return err
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return &SchemaMismatchError{Path: path, Err: fmt.Errorf("expected string, got %T", value)}
+		}
+	case "number", "integer":
+		if _, ok := value.(float64); !ok {
+			return &SchemaMismatchError{Path: path, Err: fmt.Errorf("expected number, got %T", value)}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return &SchemaMismatchError{Path: path, Err: fmt.Errorf("expected boolean, got %T", value)}
+		}
+	}
+	return nil
+}
+
+// requiredFields extracts the list of required property names from
+// schema["required"]. It accepts both []string (as built by hand, e.g. in
+// tests) and []any (as produced by encoding/json when a schema is decoded
+// from the wire), ignoring any non-string elements.
+func requiredFields(schema map[string]any) []string {
+	switch required := schema["required"].(type) {
+	case []string:
+		return required
+	case []any:
+		names := make([]string, 0, len(required))
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				names = append(names, name)
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+// Extract submits a prompt derived from T's struct tags (see [promptFor]) to
+// [GenerateSchemaService], crawls url with the schema it returns, and decodes
+// the first extracted page into a T, returning a [SchemaMismatchError] if the
+// result doesn't match that schema.
+func Extract[T any](ctx context.Context, client *Client, url string, opts ...PollOption) (result T, err error) {
+	schema, err := generateSchema(ctx, client, reflect.TypeOf(result), param.Opt[string]{Value: url, Valid: true}, opts...)
+	if err != nil {
+		return result, err
+	}
+
+	res, err := client.Smartcrawler.NewAndWait(ctx, SmartcrawlerNewParams{
+		URL:          url,
+		Prompt:       "Extract the fields described by the output schema from this page.",
+		OutputSchema: schema,
+	}, opts...)
+	if err != nil {
+		return result, err
+	}
+	if res.Status != "completed" {
+		return result, fmt.Errorf("smartcrawler session %s did not complete: status %s: %s", res.SessionID, res.Status, res.Error)
+	}
+	if len(res.Pages) == 0 {
+		return result, fmt.Errorf("smartcrawler session %s returned no pages", res.SessionID)
+	}
+
+	return decodeAgainstSchema[T](res.Pages[0].Result, schema)
+}
+
+// Search submits a prompt derived from T's struct tags (see [promptFor]) to
+// [GenerateSchemaService], runs query through [SearchscraperService] with the
+// schema it returns, and decodes every matching result into a T, returning a
+// [SchemaMismatchError] if the results don't match that schema.
+func Search[T any](ctx context.Context, client *Client, query string, opts ...PollOption) (results []T, err error) {
+	var zero T
+	itemSchema, err := generateSchema(ctx, client, reflect.TypeOf(zero), param.Opt[string]{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	schema := map[string]any{"type": "array", "items": itemSchema}
+
+	res, err := client.Searchscraper.NewAndWait(ctx, SearchscraperNewParams{
+		Query:        query,
+		UserPrompt:   "Extract the fields described by the output schema from each search result.",
+		OutputSchema: schema,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if res.Status != "completed" {
+		return nil, fmt.Errorf("searchscraper request %s did not complete: status %s: %s", res.RequestID, res.Status, res.Error)
+	}
+
+	return decodeAgainstSchema[[]T](res.Result, schema)
+}
+
+// generateSchema asks [GenerateSchemaService] for a JSON Schema matching t's
+// fields, optionally anchored to an example page.
+func generateSchema(ctx context.Context, client *Client, t reflect.Type, exampleURL param.Opt[string], opts ...PollOption) (map[string]any, error) {
+	res, err := client.GenerateSchema.NewAndWait(ctx, GenerateSchemaNewParams{
+		UserPrompt: promptFor(t),
+		ExampleURL: exampleURL,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if res.Status != "completed" {
+		return nil, fmt.Errorf("generate_schema request %s did not complete: status %s: %s", res.RequestID, res.Status, res.Error)
+	}
+	return res.Schema, nil
+}
+
+func decodeAgainstSchema[T any](raw json.RawMessage, schema map[string]any) (result T, err error) {
+	var generic any
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return result, err
+	}
+	if err := validateSchema("$", schema, generic); err != nil {
+		return result, err
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return result, &SchemaMismatchError{Path: "$", Err: err}
+	}
+	return result, nil
+}
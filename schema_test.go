@@ -0,0 +1,120 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	scrapegraphaisdk "github.com/ScrapeGraphAI/scrapegraph-sdk"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+)
+
+type product struct {
+	Price float64 `scrape:"price" desc:"the product's price in USD"`
+	Title string  `scrape:"title"`
+}
+
+// TestExtractUsesGeneratedSchema asserts that [scrapegraphaisdk.Extract]
+// submits the derived prompt to GenerateSchemaService and passes *its*
+// returned schema to Smartcrawler, rather than deriving and using a schema
+// purely locally.
+func TestExtractUsesGeneratedSchema(t *testing.T) {
+	const generatedSchema = `{"type":"object","properties":{"price":{"type":"number"},"title":{"type":"string"}},"required":["price","title"]}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/generate_schema", func(w http.ResponseWriter, r *http.Request) {
+		var body scrapegraphaisdk.GenerateSchemaNewParams
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode generate_schema request: %v", err)
+		}
+		wantPrompt := "Extract the following fields: price (the product's price in USD), title"
+		if body.UserPrompt != wantPrompt {
+			t.Errorf("generate_schema request UserPrompt = %q, want %q", body.UserPrompt, wantPrompt)
+		}
+		if !body.ExampleURL.Valid || body.ExampleURL.Value != "https://example.com/product" {
+			t.Errorf("generate_schema request ExampleURL = %+v, want https://example.com/product", body.ExampleURL)
+		}
+		fmt.Fprint(w, `{"request_id":"gs-1","status":"queued"}`)
+	})
+	mux.HandleFunc("/generate_schema/gs-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"request_id":"gs-1","status":"completed","schema":%s}`, generatedSchema)
+	})
+	mux.HandleFunc("/smartcrawler", func(w http.ResponseWriter, r *http.Request) {
+		var body scrapegraphaisdk.SmartcrawlerNewParams
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode smartcrawler request: %v", err)
+		}
+		got, err := json.Marshal(body.OutputSchema)
+		if err != nil {
+			t.Fatalf("marshal OutputSchema: %v", err)
+		}
+		var want, gotGeneric any
+		if err := json.Unmarshal([]byte(generatedSchema), &want); err != nil {
+			t.Fatalf("unmarshal generatedSchema: %v", err)
+		}
+		if err := json.Unmarshal(got, &gotGeneric); err != nil {
+			t.Fatalf("unmarshal OutputSchema: %v", err)
+		}
+		wantJSON, _ := json.Marshal(want)
+		gotJSON, _ := json.Marshal(gotGeneric)
+		if string(wantJSON) != string(gotJSON) {
+			t.Errorf("smartcrawler OutputSchema = %s, want %s", gotJSON, wantJSON)
+		}
+		fmt.Fprint(w, `{"session_id":"sc-1","status":"queued"}`)
+	})
+	mux.HandleFunc("/smartcrawler/sc-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"session_id":"sc-1","status":"completed","pages":[{"url":"https://example.com/product","result":{"price":9.99,"title":"Widget"}}]}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := scrapegraphaisdk.NewClient(option.WithBaseURL(srv.URL+"/"), option.WithAPIKey("test"))
+	result, err := scrapegraphaisdk.Extract[product](context.Background(), &client, "https://example.com/product",
+		scrapegraphaisdk.WithPollInitialInterval(0))
+	if err != nil {
+		t.Fatalf("Extract() err = %v", err)
+	}
+	if result.Price != 9.99 || result.Title != "Widget" {
+		t.Fatalf("Extract() = %+v, want {Price:9.99 Title:Widget}", result)
+	}
+}
+
+// TestExtractMissingRequiredFieldReturnsSchemaMismatchError asserts that a
+// schema's "required" list, which arrives over the wire as a JSON array and
+// so decodes as []any rather than []string, is still enforced.
+func TestExtractMissingRequiredFieldReturnsSchemaMismatchError(t *testing.T) {
+	const generatedSchema = `{"type":"object","properties":{"price":{"type":"number"},"title":{"type":"string"}},"required":["price","title"]}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/generate_schema", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"request_id":"gs-1","status":"queued"}`)
+	})
+	mux.HandleFunc("/generate_schema/gs-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"request_id":"gs-1","status":"completed","schema":%s}`, generatedSchema)
+	})
+	mux.HandleFunc("/smartcrawler", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"session_id":"sc-1","status":"queued"}`)
+	})
+	mux.HandleFunc("/smartcrawler/sc-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"session_id":"sc-1","status":"completed","pages":[{"url":"https://example.com/product","result":{"price":9.99}}]}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := scrapegraphaisdk.NewClient(option.WithBaseURL(srv.URL+"/"), option.WithAPIKey("test"))
+	_, err := scrapegraphaisdk.Extract[product](context.Background(), &client, "https://example.com/product",
+		scrapegraphaisdk.WithPollInitialInterval(0))
+	var mismatch *scrapegraphaisdk.SchemaMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("Extract() err = %v, want *SchemaMismatchError", err)
+	}
+	if mismatch.Path != "$.title" {
+		t.Fatalf("SchemaMismatchError.Path = %q, want %q", mismatch.Path, "$.title")
+	}
+}
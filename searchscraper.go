@@ -4,12 +4,14 @@ package scrapegraphaisdk
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
 )
 
 // SearchscraperService contains methods and other services that help with
@@ -32,23 +34,63 @@ func NewSearchscraperService(opts ...option.RequestOption) (r SearchscraperServi
 }
 
 // POST /searchscraper
-func (r *SearchscraperService) New(ctx context.Context, opts ...option.RequestOption) (err error) {
+func (r *SearchscraperService) New(ctx context.Context, body SearchscraperNewParams, opts ...option.RequestOption) (res *SearchscraperNewResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	path := "v1/searchscraper"
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
 }
 
 // GET /searchscraper/{request_id}
-func (r *SearchscraperService) Get(ctx context.Context, requestID string, opts ...option.RequestOption) (err error) {
+func (r *SearchscraperService) Get(ctx context.Context, requestID string, opts ...option.RequestOption) (res *SearchscraperGetResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	if requestID == "" {
 		err = errors.New("missing required request_id parameter")
 		return
 	}
 	path := fmt.Sprintf("v1/searchscraper/%s", requestID)
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
+
+// SearchscraperNewParams is the request body for [SearchscraperService.New].
+type SearchscraperNewParams struct {
+	// UserPrompt describes what to extract from the search results.
+	UserPrompt string `json:"user_prompt"`
+	// Query is the search query to run before scraping the resulting pages.
+	Query string `json:"query"`
+	// NumResults caps how many search results are scraped. The server defaults to
+	// 3 when this is omitted.
+	NumResults param.Opt[int64] `json:"num_results,omitzero"`
+	// OutputSchema is a JSON Schema describing the shape the extracted result
+	// should be coerced into. See [GenerateSchemaService] for how to obtain one.
+	OutputSchema map[string]any `json:"output_schema,omitzero"`
+	paramObj
+}
+
+func (r SearchscraperNewParams) MarshalJSON() (data []byte, err error) {
+	type shadow SearchscraperNewParams
+	return param.MarshalObject(r, (*shadow)(&r))
+}
+
+// SearchscraperNewResponse is returned from [SearchscraperService.New].
+type SearchscraperNewResponse struct {
+	// RequestID can be passed to [SearchscraperService.Get] to poll for the result.
+	RequestID string `json:"request_id"`
+	Status    string `json:"status"`
+}
+
+// SearchscraperGetResponse is returned from [SearchscraperService.Get].
+type SearchscraperGetResponse struct {
+	RequestID string `json:"request_id"`
+	// Status is one of "queued", "processing", "completed", "failed", or
+	// "cancelled".
+	Status string `json:"status"`
+	// Result is only populated once Status is "completed". Its shape follows
+	// OutputSchema when one was supplied on the request.
+	Result json.RawMessage `json:"result"`
+	// References lists the source URLs the result was extracted from.
+	References []string `json:"reference_urls"`
+	// Error is only populated once Status is "failed".
+	Error string `json:"error"`
+}
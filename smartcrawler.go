@@ -4,12 +4,14 @@ package scrapegraphaisdk
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
 	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
 )
 
 // SmartcrawlerService contains methods and other services that help with
@@ -34,23 +36,84 @@ func NewSmartcrawlerService(opts ...option.RequestOption) (r SmartcrawlerService
 }
 
 // POST /smartcrawler
-func (r *SmartcrawlerService) New(ctx context.Context, opts ...option.RequestOption) (err error) {
+func (r *SmartcrawlerService) New(ctx context.Context, body SmartcrawlerNewParams, opts ...option.RequestOption) (res *SmartcrawlerNewResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	path := "smartcrawler"
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodPost, path, body, &res, opts...)
 	return
 }
 
 // GET /smartcrawler/{session_id}
-func (r *SmartcrawlerService) Get(ctx context.Context, sessionID string, opts ...option.RequestOption) (err error) {
+func (r *SmartcrawlerService) Get(ctx context.Context, sessionID string, opts ...option.RequestOption) (res *SmartcrawlerGetResponse, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	if sessionID == "" {
 		err = errors.New("missing required session_id parameter")
 		return
 	}
 	path := fmt.Sprintf("smartcrawler/%s", sessionID)
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, nil, opts...)
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
+
+// SmartcrawlerSessionConfig controls how a smartcrawler session reuses
+// previously fetched pages and browser state.
+type SmartcrawlerSessionConfig struct {
+	// SessionID reuses an existing crawl session instead of starting a new one.
+	SessionID param.Opt[string] `json:"session_id,omitzero"`
+	// UseCache skips re-fetching pages already seen in the reused session.
+	UseCache param.Opt[bool] `json:"use_cache,omitzero"`
+	paramObj
+}
+
+func (r SmartcrawlerSessionConfig) MarshalJSON() (data []byte, err error) {
+	type shadow SmartcrawlerSessionConfig
+	return param.MarshalObject(r, (*shadow)(&r))
+}
+
+// SmartcrawlerNewParams is the request body for [SmartcrawlerService.New].
+type SmartcrawlerNewParams struct {
+	// URL is the starting page for the crawl.
+	URL string `json:"url"`
+	// Prompt describes what to extract from each page the crawl visits.
+	Prompt string `json:"prompt"`
+	// Depth caps how many link-hops away from URL the crawl will follow. The
+	// server defaults to 1 when this is omitted.
+	Depth param.Opt[int64] `json:"depth,omitzero"`
+	// SessionConfig controls reuse of a previous crawl session.
+	SessionConfig SmartcrawlerSessionConfig `json:"session_config,omitzero"`
+	// OutputSchema is a JSON Schema describing the shape each page's extracted
+	// result should be coerced into. See [GenerateSchemaService] for how to
+	// obtain one.
+	OutputSchema map[string]any `json:"output_schema,omitzero"`
+	paramObj
+}
+
+func (r SmartcrawlerNewParams) MarshalJSON() (data []byte, err error) {
+	type shadow SmartcrawlerNewParams
+	return param.MarshalObject(r, (*shadow)(&r))
+}
+
+// SmartcrawlerNewResponse is returned from [SmartcrawlerService.New].
+type SmartcrawlerNewResponse struct {
+	// SessionID can be passed to [SmartcrawlerService.Get] to poll for the result.
+	SessionID string `json:"session_id"`
+	Status    string `json:"status"`
+}
+
+// SmartcrawlerGetResponse is returned from [SmartcrawlerService.Get].
+type SmartcrawlerGetResponse struct {
+	SessionID string `json:"session_id"`
+	// Status is one of "queued", "processing", "completed", "failed", or
+	// "cancelled".
+	Status string `json:"status"`
+	// Pages is only populated once Status is "completed".
+	Pages []SmartcrawlerPage `json:"pages"`
+	// Error is only populated once Status is "failed".
+	Error string `json:"error"`
+}
+
+// SmartcrawlerPage is a single crawled and extracted page.
+type SmartcrawlerPage struct {
+	URL    string          `json:"url"`
+	Result json.RawMessage `json:"result"`
+}
@@ -0,0 +1,166 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/streaming"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
+)
+
+// CrawlEvent is a single progress event emitted by a [CrawlStream].
+type CrawlEvent struct {
+	// Type is one of "page_discovered", "page_fetched", "page_extracted",
+	// "session_done", or "error".
+	Type string `json:"type"`
+	// SessionID identifies the crawl session this event belongs to. It is
+	// captured so a dropped connection can reconnect to the same session
+	// instead of starting a new crawl.
+	SessionID string `json:"session_id"`
+	// URL is set on "page_discovered", "page_fetched", and "page_extracted"
+	// events.
+	URL string `json:"url"`
+	// Result is only set on "page_extracted" events.
+	Result json.RawMessage `json:"result"`
+	// Error is only set on "error" events.
+	Error string `json:"error"`
+}
+
+// CrawlStream iterates over the progress events of a single smartcrawler
+// run. Obtain one from [SmartcrawlerService.NewStreaming].
+//
+// Call Next in a loop to advance the stream; Current returns the event most
+// recently returned by Next. Always call Close once done, e.g. via defer.
+type CrawlStream struct {
+	ctx         context.Context
+	opts        []option.RequestOption
+	body        SmartcrawlerNewParams
+	dec         *streaming.Decoder
+	res         *http.Response
+	cur         CrawlEvent
+	err         error
+	lastEventID string
+	sessionID   string
+	started     bool
+	done        bool
+}
+
+// NewStreaming returns a [CrawlStream] that, on its first call to Next, opens
+// the smartcrawler request with Accept: text/event-stream and then yields
+// progress events as they arrive, instead of requiring the caller to poll
+// [SmartcrawlerService.Get]. No request is sent before that first Next.
+func (r *SmartcrawlerService) NewStreaming(ctx context.Context, body SmartcrawlerNewParams, opts ...option.RequestOption) *CrawlStream {
+	opts = append(r.Options[:], opts...)
+	return &CrawlStream{ctx: ctx, opts: opts, body: body}
+}
+
+func (s *CrawlStream) connect() error {
+	opts := append([]option.RequestOption{option.WithHeader("Accept", "text/event-stream")}, s.opts...)
+	if s.lastEventID != "" {
+		opts = append(opts, option.WithHeader("Last-Event-ID", s.lastEventID))
+	}
+	body := s.body
+	if s.sessionID != "" {
+		// Resume the session we were already streaming instead of starting a
+		// brand-new (and separately billed) crawl.
+		body.SessionConfig = SmartcrawlerSessionConfig{
+			SessionID: param.Opt[string]{Value: s.sessionID, Valid: true},
+			UseCache:  param.Opt[bool]{Value: true, Valid: true},
+		}
+	}
+	res, err := requestconfig.ExecuteNewStreamingRequest(s.ctx, http.MethodPost, "smartcrawler", body, opts...)
+	if err != nil {
+		return err
+	}
+	s.res = res
+	s.dec = streaming.NewDecoder(res.Body)
+	return nil
+}
+
+// Next advances the stream to the next event, reconnecting to the same crawl
+// session (via SmartcrawlerSessionConfig.SessionID) from the last seen event
+// ID if the underlying connection drops. It returns false once the crawl
+// session finishes or an unrecoverable error occurs; check Err to tell the
+// two apart.
+func (s *CrawlStream) Next() bool {
+	if s.err != nil || s.done {
+		return false
+	}
+	if !s.started {
+		s.started = true
+		if err := s.connect(); err != nil {
+			s.err = err
+			return false
+		}
+	}
+
+	for {
+		if s.dec.Next() {
+			ev := s.dec.Event()
+			if ev.ID != "" {
+				s.lastEventID = ev.ID
+			}
+			if err := json.Unmarshal(ev.Data, &s.cur); err != nil {
+				s.err = err
+				return false
+			}
+			if s.cur.SessionID != "" {
+				s.sessionID = s.cur.SessionID
+			}
+			if s.cur.Type == "session_done" {
+				s.done = true
+				s.dec.Close()
+			}
+			return true
+		}
+		if err := s.dec.Err(); err != nil {
+			s.dec.Close()
+			if reconnectErr := s.connect(); reconnectErr != nil {
+				s.err = errors.Join(err, reconnectErr)
+				return false
+			}
+			continue
+		}
+		return false
+	}
+}
+
+// Current returns the event most recently returned by Next.
+func (s *CrawlStream) Current() CrawlEvent { return s.cur }
+
+// Err returns the first error encountered while streaming, if any.
+func (s *CrawlStream) Err() error { return s.err }
+
+// Close releases the underlying connection. It is safe to call multiple
+// times.
+func (s *CrawlStream) Close() error {
+	if s.dec == nil {
+		return nil
+	}
+	return s.dec.Close()
+}
+
+// Events returns a channel of events for select-loop consumers, closed once
+// the stream ends. The stream is automatically closed when the channel is
+// closed; check Err afterwards to detect a failed run.
+func (s *CrawlStream) Events() <-chan CrawlEvent {
+	ch := make(chan CrawlEvent)
+	go func() {
+		defer close(ch)
+		defer s.Close()
+		for s.Next() {
+			select {
+			case ch <- s.Current():
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
@@ -0,0 +1,93 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	scrapegraphaisdk "github.com/ScrapeGraphAI/scrapegraph-sdk"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+)
+
+// TestCrawlStreamReconnectResumesSession exercises a dropped connection
+// followed by a clean session_done, and asserts that:
+//   - the reconnect targets the session already in progress via
+//     SmartcrawlerSessionConfig.SessionID, instead of starting a new crawl
+//   - Next() returns false after session_done without attempting to
+//     reconnect (and so without starting another billable crawl)
+func TestCrawlStreamReconnectResumesSession(t *testing.T) {
+	var requests int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/smartcrawler", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		var body scrapegraphaisdk.SmartcrawlerNewParams
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+
+		switch n {
+		case 1:
+			if body.SessionConfig.SessionID.Valid {
+				t.Errorf("first request should not target an existing session, got %q", body.SessionConfig.SessionID.Value)
+			}
+			// Simulate a mid-stream connection drop: declare more content
+			// than we actually send, then close the raw connection.
+			hj, ok := w.(http.Hijacker)
+			if !ok {
+				t.Fatal("test server does not support hijacking")
+			}
+			conn, bufrw, err := hj.Hijack()
+			if err != nil {
+				t.Fatalf("hijack: %v", err)
+			}
+			defer conn.Close()
+			event := "data: {\"type\":\"page_discovered\",\"session_id\":\"sess-1\",\"url\":\"https://example.com\"}\n\n"
+			fmt.Fprintf(bufrw, "HTTP/1.1 200 OK\r\nContent-Type: text/event-stream\r\nContent-Length: %d\r\n\r\n%s", len(event)+100, event)
+			bufrw.Flush()
+		case 2:
+			if !body.SessionConfig.SessionID.Valid || body.SessionConfig.SessionID.Value != "sess-1" {
+				t.Errorf("reconnect should resume sess-1, got %+v", body.SessionConfig.SessionID)
+			}
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data: {\"type\":\"session_done\",\"session_id\":\"sess-1\"}\n\n")
+		default:
+			t.Errorf("unexpected request %d; Next() should not reconnect after session_done", n)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := scrapegraphaisdk.NewClient(option.WithBaseURL(srv.URL+"/"), option.WithAPIKey("test"))
+	stream := client.Smartcrawler.NewStreaming(context.Background(), scrapegraphaisdk.SmartcrawlerNewParams{
+		URL:    "https://example.com",
+		Prompt: "extract",
+	})
+	defer stream.Close()
+
+	var events []scrapegraphaisdk.CrawlEvent
+	for stream.Next() {
+		events = append(events, stream.Current())
+	}
+	if err := stream.Err(); err != nil {
+		t.Fatalf("stream.Err() = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(events), events)
+	}
+	if events[1].Type != "session_done" {
+		t.Fatalf("last event type = %q, want session_done", events[1].Type)
+	}
+
+	if stream.Next() {
+		t.Fatal("Next() returned true after session_done")
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Fatalf("server saw %d requests, want exactly 2 (no reconnect after session_done)", got)
+	}
+}
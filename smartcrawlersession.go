@@ -5,9 +5,12 @@ package scrapegraphaisdk
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"strconv"
 
-	"github.com/stainless-sdks/scrapegraphai-sdk-go/internal/requestconfig"
-	"github.com/stainless-sdks/scrapegraphai-sdk-go/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/internal/requestconfig"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
 )
 
 // SmartcrawlerSessionService contains methods and other services that help with
@@ -30,10 +33,139 @@ func NewSmartcrawlerSessionService(opts ...option.RequestOption) (r Smartcrawler
 }
 
 // GET /smartcrawler/sessions/all
-func (r *SmartcrawlerSessionService) List(ctx context.Context, opts ...option.RequestOption) (err error) {
+func (r *SmartcrawlerSessionService) List(ctx context.Context, params SmartcrawlerSessionListParams, opts ...option.RequestOption) (res *SessionsPage, err error) {
 	opts = append(r.Options[:], opts...)
-	opts = append([]option.RequestOption{option.WithHeader("Accept", "")}, opts...)
 	path := "smartcrawler/sessions/all"
-	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, nil, opts...)
+	if query := params.urlValues().Encode(); query != "" {
+		path += "?" + query
+	}
+	err = requestconfig.ExecuteNewRequest(ctx, http.MethodGet, path, nil, &res, opts...)
 	return
 }
+
+// ListAutoPaging is like [SmartcrawlerSessionService.List], but returns a
+// [SessionsIter] that transparently follows SessionsPage.NextCursor, so
+// callers can range over arbitrarily many sessions without manual cursor
+// bookkeeping.
+func (r *SmartcrawlerSessionService) ListAutoPaging(ctx context.Context, params SmartcrawlerSessionListParams, opts ...option.RequestOption) *SessionsIter {
+	return &SessionsIter{ctx: ctx, svc: r, params: params, opts: opts}
+}
+
+// SmartcrawlerSessionListParams is the query for [SmartcrawlerSessionService.List].
+type SmartcrawlerSessionListParams struct {
+	// Status filters sessions by their current status: "running", "completed",
+	// "failed", or "cancelled".
+	Status param.Opt[string]
+	// CreatedAfter filters to sessions created at or after this RFC3339
+	// timestamp.
+	CreatedAfter param.Opt[string]
+	// CreatedBefore filters to sessions created at or before this RFC3339
+	// timestamp.
+	CreatedBefore param.Opt[string]
+	// URLContains filters to sessions whose starting URL contains this substring.
+	URLContains param.Opt[string]
+	// Limit caps the number of sessions returned in a single page. The server
+	// defaults to 20 when this is omitted.
+	Limit param.Opt[int64]
+	// Cursor resumes listing from the SessionsPage.NextCursor of a previous
+	// page.
+	Cursor param.Opt[string]
+	// OrderBy sorts results, e.g. "created_at" or "-created_at" for descending.
+	OrderBy param.Opt[string]
+}
+
+func (p SmartcrawlerSessionListParams) urlValues() url.Values {
+	v := url.Values{}
+	if p.Status.Valid {
+		v.Set("status", p.Status.Value)
+	}
+	if p.CreatedAfter.Valid {
+		v.Set("created_after", p.CreatedAfter.Value)
+	}
+	if p.CreatedBefore.Valid {
+		v.Set("created_before", p.CreatedBefore.Value)
+	}
+	if p.URLContains.Valid {
+		v.Set("url_contains", p.URLContains.Value)
+	}
+	if p.Limit.Valid {
+		v.Set("limit", strconv.FormatInt(p.Limit.Value, 10))
+	}
+	if p.Cursor.Valid {
+		v.Set("cursor", p.Cursor.Value)
+	}
+	if p.OrderBy.Valid {
+		v.Set("order_by", p.OrderBy.Value)
+	}
+	return v
+}
+
+// Session describes a single smartcrawler session.
+type Session struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Status is one of "running", "completed", "failed", or "cancelled".
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+// SessionsPage is a single page of results from
+// [SmartcrawlerSessionService.List].
+type SessionsPage struct {
+	Sessions []Session `json:"sessions"`
+	// NextCursor, if non-empty, can be passed as
+	// SmartcrawlerSessionListParams.Cursor to fetch the next page.
+	NextCursor string `json:"next_cursor"`
+	// HasMore reports whether NextCursor refers to another page.
+	HasMore bool `json:"has_more"`
+}
+
+// SessionsIter auto-paginates through every session matching a
+// [SmartcrawlerSessionListParams] query. Obtain one from
+// [SmartcrawlerSessionService.ListAutoPaging].
+type SessionsIter struct {
+	ctx    context.Context
+	svc    *SmartcrawlerSessionService
+	params SmartcrawlerSessionListParams
+	opts   []option.RequestOption
+
+	page []Session
+	idx  int
+	cur  Session
+	err  error
+	done bool
+}
+
+// Next advances to the next session, fetching additional pages as needed. It
+// returns false once every matching session has been visited or an error
+// occurs; check Err to tell the two apart.
+func (it *SessionsIter) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for it.idx >= len(it.page) {
+		if it.done {
+			return false
+		}
+		page, err := it.svc.List(it.ctx, it.params, it.opts...)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.idx = page.Sessions, 0
+		if page.HasMore {
+			it.params.Cursor = param.Opt[string]{Value: page.NextCursor, Valid: true}
+		} else {
+			it.done = true
+		}
+	}
+	it.cur = it.page[it.idx]
+	it.idx++
+	return true
+}
+
+// Current returns the session most recently returned by Next.
+func (it *SessionsIter) Current() Session { return it.cur }
+
+// Err returns the first error encountered while paging, if any.
+func (it *SessionsIter) Err() error { return it.err }
@@ -0,0 +1,85 @@
+// File generated from our OpenAPI spec by Stainless. See CONTRIBUTING.md for details.
+
+package scrapegraphaisdk_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	scrapegraphaisdk "github.com/ScrapeGraphAI/scrapegraph-sdk"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/option"
+	"github.com/ScrapeGraphAI/scrapegraph-sdk/packages/param"
+)
+
+func TestSmartcrawlerSessionListFilters(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/smartcrawler/sessions/all", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got, want := q.Get("status"), "completed"; got != want {
+			t.Errorf("status = %q, want %q", got, want)
+		}
+		if got, want := q.Get("url_contains"), "example.com"; got != want {
+			t.Errorf("url_contains = %q, want %q", got, want)
+		}
+		if got, want := q.Get("order_by"), "-created_at"; got != want {
+			t.Errorf("order_by = %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `{"sessions":[],"next_cursor":"","has_more":false}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := scrapegraphaisdk.NewClient(option.WithBaseURL(srv.URL+"/"), option.WithAPIKey("test"))
+	_, err := client.Smartcrawler.Sessions.List(context.Background(), scrapegraphaisdk.SmartcrawlerSessionListParams{
+		Status:      param.Opt[string]{Value: "completed", Valid: true},
+		URLContains: param.Opt[string]{Value: "example.com", Valid: true},
+		OrderBy:     param.Opt[string]{Value: "-created_at", Valid: true},
+	})
+	if err != nil {
+		t.Fatalf("List() err = %v", err)
+	}
+}
+
+func TestSmartcrawlerSessionListAutoPaging(t *testing.T) {
+	var requests []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/smartcrawler/sessions/all", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Query().Get("cursor"))
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{"sessions":[{"id":"s1","url":"https://a","status":"completed","created_at":"2026-01-01T00:00:00Z"},{"id":"s2","url":"https://b","status":"completed","created_at":"2026-01-02T00:00:00Z"}],"next_cursor":"page2","has_more":true}`)
+		case "page2":
+			fmt.Fprint(w, `{"sessions":[{"id":"s3","url":"https://c","status":"completed","created_at":"2026-01-03T00:00:00Z"}],"next_cursor":"","has_more":false}`)
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := scrapegraphaisdk.NewClient(option.WithBaseURL(srv.URL+"/"), option.WithAPIKey("test"))
+	it := client.Smartcrawler.Sessions.ListAutoPaging(context.Background(), scrapegraphaisdk.SmartcrawlerSessionListParams{})
+
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Current().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	want := []string{"s1", "s2", "s3"}
+	if len(ids) != len(want) {
+		t.Fatalf("got %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("got %v, want %v", ids, want)
+		}
+	}
+	if len(requests) != 2 {
+		t.Fatalf("server saw %d requests, want 2 (one per page)", len(requests))
+	}
+}
@@ -26,7 +26,7 @@ func TestSmartcrawlerSessionList(t *testing.T) {
 		option.WithBaseURL(baseURL),
 		option.WithAPIKey("My API Key"),
 	)
-	err := client.Smartcrawler.Sessions.List(context.TODO())
+	_, err := client.Smartcrawler.Sessions.List(context.TODO(), scrapegraphaisdk.SmartcrawlerSessionListParams{})
 	if err != nil {
 		var apierr *scrapegraphaisdk.Error
 		if errors.As(err, &apierr) {